@@ -0,0 +1,136 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package validation
+
+var (
+	// ErrBetweenInclusive is the error that returns when a value is not
+	// between min and max, with both bounds inclusive.
+	ErrBetweenInclusive = NewError("validation_between_inclusive", "must be between {{.min}} and {{.max}}")
+	// ErrBetweenExclusiveMin is the error that returns when a value is not
+	// between min and max, with min exclusive and max inclusive.
+	ErrBetweenExclusiveMin = NewError("validation_between_exclusive_min", "must be between {{.min}} and {{.max}}")
+	// ErrBetweenExclusiveMax is the error that returns when a value is not
+	// between min and max, with min inclusive and max exclusive.
+	ErrBetweenExclusiveMax = NewError("validation_between_exclusive_max", "must be between {{.min}} and {{.max}}")
+	// ErrBetweenExclusive is the error that returns when a value is not
+	// between min and max, with both bounds exclusive.
+	ErrBetweenExclusive = NewError("validation_between_exclusive", "must be between {{.min}} and {{.max}}")
+)
+
+// BetweenRule is a validation rule that checks if a value is between the
+// specified min and max, inclusive on both ends by default.
+type BetweenRule struct {
+	min, max     any
+	exclusiveMin bool
+	exclusiveMax bool
+	strict       bool
+	err          Error
+}
+
+// Between returns a validation rule that checks if a value is between min
+// and max, inclusive on both ends. By calling ExclusiveMin, ExclusiveMax, or
+// Exclusive, either or both bounds can be excluded from the valid range.
+// Note that the value being checked and min/max must be of the same type.
+// Between supports the same bound and value types as Min and Max.
+// An empty value is considered valid. Please use the Required rule to make
+// sure a value is not empty.
+func Between(min, max any) BetweenRule {
+	return BetweenRule{
+		min: min,
+		max: max,
+		err: ErrBetweenInclusive,
+	}
+}
+
+// ExclusiveMin excludes the min bound from the valid range.
+func (r BetweenRule) ExclusiveMin() BetweenRule {
+	r.exclusiveMin = true
+	r.err = r.betweenError()
+	return r
+}
+
+// ExclusiveMax excludes the max bound from the valid range.
+func (r BetweenRule) ExclusiveMax() BetweenRule {
+	r.exclusiveMax = true
+	r.err = r.betweenError()
+	return r
+}
+
+// Exclusive excludes both the min and max bounds from the valid range.
+func (r BetweenRule) Exclusive() BetweenRule {
+	r.exclusiveMin = true
+	r.exclusiveMax = true
+	r.err = r.betweenError()
+	return r
+}
+
+// Strict disables the "a zero value is empty" short-circuit for numeric
+// bounds, mirroring ThresholdRule.Strict. time.Time, string, and []byte
+// bounds are unaffected.
+func (r BetweenRule) Strict() BetweenRule {
+	r.strict = true
+	return r
+}
+
+func (r BetweenRule) betweenError() Error {
+	switch {
+	case r.exclusiveMin && r.exclusiveMax:
+		return ErrBetweenExclusive
+	case r.exclusiveMin:
+		return ErrBetweenExclusiveMin
+	case r.exclusiveMax:
+		return ErrBetweenExclusiveMax
+	default:
+		return ErrBetweenInclusive
+	}
+}
+
+// Validate checks if the given value is valid or not.
+func (r BetweenRule) Validate(value any) error {
+	minOp := greaterEqualThan
+	if r.exclusiveMin {
+		minOp = greaterThan
+	}
+	maxOp := lessEqualThan
+	if r.exclusiveMax {
+		maxOp = lessThan
+	}
+
+	// Reuse ThresholdRule's type dispatch so Between automatically supports
+	// every type Min/Max support, including anything registered with
+	// RegisterComparator. The original, un-indirected value is passed through
+	// untouched so ThresholdRule.Validate owns the single Indirect/raw split
+	// it already performs for Min/Max; pre-indirecting here would break
+	// pointer-receiver-based Comparable/Comparator lookups like *big.Int.
+	min := ThresholdRule{threshold: r.min, operator: minOp, strict: r.strict, err: r.err}
+	max := ThresholdRule{threshold: r.max, operator: maxOp, strict: r.strict, err: r.err}
+
+	if err := min.Validate(value); err != nil {
+		if _, ok := err.(Error); !ok {
+			return err
+		}
+		return r.err.SetParams(map[string]any{"min": r.min, "max": r.max})
+	}
+	if err := max.Validate(value); err != nil {
+		if _, ok := err.(Error); !ok {
+			return err
+		}
+		return r.err.SetParams(map[string]any{"min": r.min, "max": r.max})
+	}
+
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r BetweenRule) Error(message string) BetweenRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r BetweenRule) ErrorObject(err Error) BetweenRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,95 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package validation
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		tag          string
+		min, max     any
+		exclusiveMin bool
+		exclusiveMax bool
+		value        any
+		err          string
+	}{
+		{"t1.1", 1, 10, false, false, 5, ""},
+		{"t1.2", 1, 10, false, false, 1, ""},
+		{"t1.3", 1, 10, false, false, 10, ""},
+		{"t1.4", 1, 10, false, false, -1, "must be between 1 and 10"},
+		{"t1.5", 1, 10, false, false, 11, "must be between 1 and 10"},
+		{"t1.6", 1, 10, true, false, 1, "must be between 1 and 10"},
+		{"t1.7", 1, 10, false, true, 10, "must be between 1 and 10"},
+		{"t1.8", 1, 10, true, true, 1, "must be between 1 and 10"},
+		{"t1.9", 1, 10, true, true, 10, "must be between 1 and 10"},
+		{"t1.10", 1, 10, true, true, 5, ""},
+	}
+
+	for _, test := range tests {
+		r := Between(test.min, test.max)
+		if test.exclusiveMin {
+			r = r.ExclusiveMin()
+		}
+		if test.exclusiveMax {
+			r = r.ExclusiveMax()
+		}
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestBetween_Strict(t *testing.T) {
+	r := Between(1, 10)
+	assert.NoError(t, r.Validate(0))
+
+	r = r.Strict()
+	assert.Error(t, r.Validate(0))
+	assert.NoError(t, r.Validate(5))
+}
+
+func TestBetween_EmptyValue(t *testing.T) {
+	r := Between(1, 10)
+	assert.NoError(t, r.Validate(""))
+	assert.NoError(t, r.Validate(nil))
+}
+
+func TestBetween_TypeMismatch(t *testing.T) {
+	r := Between(1, 10)
+	err := r.Validate("5")
+	assert.Error(t, err)
+	assert.Equal(t, "cannot convert string to int64", err.Error())
+}
+
+func TestBetween_BigInt(t *testing.T) {
+	r := Between(big.NewInt(1), big.NewInt(10))
+	assert.NoError(t, r.Validate(big.NewInt(5)))
+	assert.NoError(t, r.Validate(big.NewInt(1)))
+	assert.NoError(t, r.Validate(big.NewInt(10)))
+	assert.Error(t, r.Validate(big.NewInt(11)))
+	assert.Error(t, r.Validate(big.NewInt(-1)))
+}
+
+func TestBetweenError(t *testing.T) {
+	r := Between(1, 10)
+	assert.Equal(t, "must be between 1 and 10", r.Validate(20).Error())
+
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestBetweenRule_ErrorObject(t *testing.T) {
+	r := Between(1, 10)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,167 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package validation
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+)
+
+// Comparable is implemented by values that know how to compare themselves
+// against another value. ThresholdRule consults it before falling back to
+// the registered Comparator table, so a user type never needs to call
+// RegisterComparator if it already implements this interface.
+type Comparable interface {
+	// Cmp returns a negative number if the receiver is less than other, zero
+	// if they are equal, and a positive number if the receiver is greater
+	// than other. It returns an error if other cannot be compared to the
+	// receiver.
+	Cmp(other any) (int, error)
+}
+
+// Comparator compares two values of a type that has been registered with
+// RegisterComparator, returning a negative number if a is less than b, zero
+// if they are equal, and a positive number if a is greater than b.
+type Comparator func(a, b any) (int, error)
+
+// ComparatorParser parses the string form of a json.Number into a value of a
+// registered type, so that thresholds of that type can be compared against
+// numbers decoded from JSON.
+type ComparatorParser func(s string) (any, error)
+
+var (
+	comparatorsMu sync.RWMutex
+	comparators   = map[reflect.Type]Comparator{}
+	parsers       = map[reflect.Type]ComparatorParser{}
+)
+
+// RegisterComparator registers a Comparator to be consulted by ThresholdRule
+// and BetweenRule whenever the threshold's type matches t. Thresholds whose
+// kind is int, uint, float, string or slice, and time.Time thresholds, use
+// the built-in comparisons and never reach the registry. It is typically
+// called from an init function.
+func RegisterComparator(t reflect.Type, cmp Comparator) {
+	comparatorsMu.Lock()
+	defer comparatorsMu.Unlock()
+	comparators[t] = cmp
+}
+
+// RegisterComparatorParser registers a ComparatorParser so that json.Number
+// values can be coerced into type t before being handed to its Comparator.
+func RegisterComparatorParser(t reflect.Type, parser ComparatorParser) {
+	comparatorsMu.Lock()
+	defer comparatorsMu.Unlock()
+	parsers[t] = parser
+}
+
+func lookupComparator(t reflect.Type) (Comparator, bool) {
+	comparatorsMu.RLock()
+	defer comparatorsMu.RUnlock()
+	cmp, ok := comparators[t]
+	return cmp, ok
+}
+
+func lookupComparatorParser(t reflect.Type) (ComparatorParser, bool) {
+	comparatorsMu.RLock()
+	defer comparatorsMu.RUnlock()
+	parser, ok := parsers[t]
+	return parser, ok
+}
+
+func init() {
+	RegisterComparator(reflect.TypeOf((*big.Int)(nil)), compareBigInt)
+	RegisterComparator(reflect.TypeOf((*big.Float)(nil)), compareBigFloat)
+	RegisterComparator(reflect.TypeOf((*big.Rat)(nil)), compareBigRat)
+
+	RegisterComparatorParser(reflect.TypeOf((*big.Int)(nil)), parseBigInt)
+	RegisterComparatorParser(reflect.TypeOf((*big.Float)(nil)), parseBigFloat)
+	RegisterComparatorParser(reflect.TypeOf((*big.Rat)(nil)), parseBigRat)
+}
+
+func compareBigInt(a, b any) (int, error) {
+	av, ok := a.(*big.Int)
+	if !ok {
+		return 0, fmt.Errorf("cannot convert %v to *big.Int", reflect.TypeOf(a))
+	}
+	bv, ok := b.(*big.Int)
+	if !ok {
+		return 0, fmt.Errorf("cannot convert %v to *big.Int", reflect.TypeOf(b))
+	}
+	return av.Cmp(bv), nil
+}
+
+func compareBigFloat(a, b any) (int, error) {
+	av, ok := a.(*big.Float)
+	if !ok {
+		return 0, fmt.Errorf("cannot convert %v to *big.Float", reflect.TypeOf(a))
+	}
+	bv, ok := b.(*big.Float)
+	if !ok {
+		return 0, fmt.Errorf("cannot convert %v to *big.Float", reflect.TypeOf(b))
+	}
+	return av.Cmp(bv), nil
+}
+
+func compareBigRat(a, b any) (int, error) {
+	av, ok := a.(*big.Rat)
+	if !ok {
+		return 0, fmt.Errorf("cannot convert %v to *big.Rat", reflect.TypeOf(a))
+	}
+	bv, ok := b.(*big.Rat)
+	if !ok {
+		return 0, fmt.Errorf("cannot convert %v to *big.Rat", reflect.TypeOf(b))
+	}
+	return av.Cmp(bv), nil
+}
+
+func parseBigInt(s string) (any, error) {
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert %q to *big.Int", s)
+	}
+	return v, nil
+}
+
+func parseBigFloat(s string) (any, error) {
+	v, ok := new(big.Float).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert %q to *big.Float", s)
+	}
+	return v, nil
+}
+
+func parseBigRat(s string) (any, error) {
+	v, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert %q to *big.Rat", s)
+	}
+	return v, nil
+}
+
+// compareRegistered resolves the comparison of value against threshold,
+// consulting the Comparable interface before falling back to the registered
+// Comparator table. The returned int follows Cmp semantics for
+// Cmp(value, threshold): negative if value is less than threshold, zero if
+// equal, positive if value is greater than threshold. ok is false if neither
+// threshold nor value know how to compare themselves.
+func compareRegistered(threshold, value any) (n int, ok bool, err error) {
+	if c, isComparable := threshold.(Comparable); isComparable {
+		n, err = c.Cmp(value)
+		return -n, true, err
+	}
+	if c, isComparable := value.(Comparable); isComparable {
+		n, err = c.Cmp(threshold)
+		return n, true, err
+	}
+
+	if cmp, registered := lookupComparator(reflect.TypeOf(threshold)); registered {
+		n, err = cmp(value, threshold)
+		return n, true, err
+	}
+
+	return 0, false, nil
+}
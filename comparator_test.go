@@ -0,0 +1,105 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package validation
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMin_BigInt(t *testing.T) {
+	tests := []struct {
+		tag       string
+		threshold *big.Int
+		exclusive bool
+		value     *big.Int
+		err       string
+	}{
+		{"t1.1", big.NewInt(2), false, big.NewInt(2), ""},
+		{"t1.2", big.NewInt(2), false, big.NewInt(3), ""},
+		{"t1.3", big.NewInt(2), false, big.NewInt(1), "must be no less than 2"},
+		{"t1.4", big.NewInt(2), true, big.NewInt(2), "must be greater than 2"},
+	}
+
+	for _, test := range tests {
+		r := Min(test.threshold)
+		if test.exclusive {
+			r = r.Exclusive()
+		}
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestMax_BigIntFromJSONNumber(t *testing.T) {
+	r := Max(big.NewInt(10))
+	assert.NoError(t, r.Validate(json.Number("9")))
+	assert.Error(t, r.Validate(json.Number("11")))
+}
+
+func TestRegisterComparator_CustomType(t *testing.T) {
+	type version struct {
+		major int
+	}
+
+	RegisterComparator(reflect.TypeOf(version{}), func(a, b any) (int, error) {
+		av := a.(version)
+		bv := b.(version)
+		return av.major - bv.major, nil
+	})
+
+	r := Min(version{major: 2})
+	assert.NoError(t, r.Validate(version{major: 3}))
+	assert.Error(t, r.Validate(version{major: 1}))
+}
+
+type comparableVersion struct {
+	major int
+}
+
+func (v comparableVersion) Cmp(other any) (int, error) {
+	return v.major - other.(comparableVersion).major, nil
+}
+
+func TestMin_Comparable_ThresholdSide(t *testing.T) {
+	r := Min(comparableVersion{major: 2})
+	assert.NoError(t, r.Validate(comparableVersion{major: 3}))
+	assert.NoError(t, r.Validate(comparableVersion{major: 2}))
+	assert.Error(t, r.Validate(comparableVersion{major: 1}))
+}
+
+type ptrComparableVersion struct {
+	major int
+}
+
+func (v *ptrComparableVersion) Cmp(other any) (int, error) {
+	return v.major - other.(ptrComparableVersion).major, nil
+}
+
+func TestMin_Comparable_ValueSide_PointerReceiver(t *testing.T) {
+	r := Min(ptrComparableVersion{major: 2})
+	assert.NoError(t, r.Validate(&ptrComparableVersion{major: 3}))
+	assert.NoError(t, r.Validate(&ptrComparableVersion{major: 2}))
+	assert.Error(t, r.Validate(&ptrComparableVersion{major: 1}))
+}
+
+func TestMinT(t *testing.T) {
+	assert.NoError(t, MinT(2).Validate(3))
+	assert.Error(t, MinT(2).Validate(1))
+	assert.Error(t, MinT(2).Exclusive().Validate(2))
+
+	assert.NoError(t, MinT("b").Validate("c"))
+	assert.Error(t, MinT("b").Validate("a"))
+}
+
+func TestMaxT(t *testing.T) {
+	assert.NoError(t, MaxT(2).Validate(1))
+	assert.Error(t, MaxT(2).Validate(3))
+	assert.Error(t, MaxT(2).Exclusive().Validate(2))
+}
@@ -6,11 +6,17 @@ package validation
 
 import (
 	"reflect"
+	"strings"
 )
 
-// ErrInInvalid is the error that returns in case of an invalid value for "in"
-// rule.
-var ErrInInvalid = NewError("validation_in_invalid", "must be a valid value")
+var (
+	// ErrInInvalid is the error that returns in case of an invalid value for
+	// "in" rule.
+	ErrInInvalid = NewError("validation_in_invalid", "must be a valid value")
+	// ErrNotInInvalid is the error that returns when a value is found in the
+	// list of values given to the "not in" rule.
+	ErrNotInInvalid = NewError("validation_not_in_invalid", "must not be a valid value")
+)
 
 // In returns a validation rule that checks if a value can be found in the given
 // list of values. reflect.DeepEqual() will be used to determine if two values
@@ -28,9 +34,27 @@ func In[T any](values ...T) InRule[T] {
 // given list of values.
 type InRule[T any] struct {
 	elements []T
+	fold     bool
 	err      Error
 }
 
+// InFold returns a validation rule that checks if a string value can be
+// found in the given list of values, using a Unicode case-insensitive
+// comparison via strings.EqualFold instead of exact equality. It is
+// equivalent to calling In(values...).Fold(). An empty value is considered
+// valid. Use the Required rule to make sure a value is not empty.
+func InFold(values ...string) InRule[string] {
+	return In(values...).Fold()
+}
+
+// Fold makes the rule compare string values case-insensitively via
+// strings.EqualFold instead of reflect.DeepEqual. It has no effect when T is
+// not a string.
+func (r InRule[T]) Fold() InRule[T] {
+	r.fold = true
+	return r
+}
+
 // Validate checks if the given value is valid or not.
 func (r InRule[T]) Validate(value any) error {
 	value, isNil := Indirect(value)
@@ -39,6 +63,18 @@ func (r InRule[T]) Validate(value any) error {
 	}
 
 	for _, e := range r.elements {
+		if r.fold {
+			es, eok := any(e).(string)
+			vs, vok := value.(string)
+			if eok && vok {
+				if strings.EqualFold(es, vs) {
+					return nil
+				}
+				continue
+			}
+			// T isn't a string, so Fold has no effect per its doc comment;
+			// fall back to the same comparison In uses without Fold.
+		}
 		if reflect.DeepEqual(e, value) {
 			return nil
 		}
@@ -58,3 +94,50 @@ func (r InRule[T]) ErrorObject(err Error) InRule[T] {
 	r.err = err
 	return r
 }
+
+// NotIn returns a validation rule that checks if a value cannot be found in
+// the given list of values. reflect.DeepEqual() will be used to determine if
+// two values are equal. It is the inverse of In, useful for reserved-name or
+// blocklist checks. An empty value is considered valid. Use the Required
+// rule to make sure a value is not empty.
+func NotIn[T any](values ...T) NotInRule[T] {
+	return NotInRule[T]{
+		elements: values,
+		err:      ErrNotInInvalid,
+	}
+}
+
+// NotInRule is a validation rule that validates if a value cannot be found
+// in the given list of values.
+type NotInRule[T any] struct {
+	elements []T
+	err      Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r NotInRule[T]) Validate(value any) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	for _, e := range r.elements {
+		if reflect.DeepEqual(e, value) {
+			return r.err
+		}
+	}
+
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r NotInRule[T]) Error(message string) NotInRule[T] {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r NotInRule[T]) ErrorObject(err Error) NotInRule[T] {
+	r.err = err
+	return r
+}
@@ -0,0 +1,117 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIn(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value any
+		err   string
+	}{
+		{"t1.1", 1, ""},
+		{"t1.2", 2, ""},
+		{"t1.3", 3, "must be a valid value"},
+		{"t1.4", 0, ""},
+	}
+
+	r := In(1, 2)
+	for _, test := range tests {
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestNotIn(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value any
+		err   string
+	}{
+		{"t1.1", "admin", "must not be a valid value"},
+		{"t1.2", "root", "must not be a valid value"},
+		{"t1.3", "alice", ""},
+		{"t1.4", "", ""},
+	}
+
+	r := NotIn("admin", "root")
+	for _, test := range tests {
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestInFold(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value any
+		err   string
+	}{
+		{"t1.1", "Admin", ""},
+		{"t1.2", "ADMIN", ""},
+		{"t1.3", "root", ""},
+		{"t1.4", "guest", "must be a valid value"},
+		{"t1.5", "", ""},
+	}
+
+	r := InFold("admin", "root")
+	for _, test := range tests {
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestIn_Fold(t *testing.T) {
+	r := In("admin", "root").Fold()
+	assert.NoError(t, r.Validate("ADMIN"))
+	assert.Error(t, r.Validate("guest"))
+}
+
+func TestIn_Fold_NonString(t *testing.T) {
+	r := In(1, 2, 3).Fold()
+	assert.NoError(t, r.Validate(2))
+	assert.Error(t, r.Validate(4))
+}
+
+func TestInError(t *testing.T) {
+	r := In(1, 2)
+	assert.Equal(t, "must be a valid value", r.Validate(3).Error())
+
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestInRule_ErrorObject(t *testing.T) {
+	r := In(1, 2)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
+
+func TestNotInError(t *testing.T) {
+	r := NotIn(1, 2)
+	assert.Equal(t, "must not be a valid value", r.Validate(1).Error())
+
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestNotInRule_ErrorObject(t *testing.T) {
+	r := NotIn(1, 2)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -5,10 +5,16 @@
 package validation
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"math"
+	"math/big"
 	"reflect"
+	"strings"
 	"time"
+
+	"golang.org/x/exp/constraints"
 )
 
 var (
@@ -26,6 +32,7 @@ var (
 type ThresholdRule struct {
 	threshold any
 	operator  int
+	strict    bool
 	err       Error
 }
 
@@ -39,7 +46,11 @@ const (
 // Min returns a validation rule that checks if a value is greater or equal than the specified value.
 // By calling Exclusive, the rule will check if the value is strictly greater than the specified value.
 // Note that the value being checked and the threshold value must be of the same type.
-// Only int, uint, float and time.Time types are supported.
+// Supports int, uint, float, time.Time, string and []byte thresholds, and
+// thresholds of any other kind (e.g. a struct or pointer) that implement
+// Comparable or are registered with RegisterComparator. json.Number values are
+// parsed for int, uint, float and time.Time thresholds and for types
+// registered with RegisterComparatorParser.
 // An empty value is considered valid. Please use the Required rule to make sure a value is not empty.
 func Min(min any) ThresholdRule {
 	return ThresholdRule{
@@ -53,7 +64,11 @@ func Min(min any) ThresholdRule {
 // Max returns a validation rule that checks if a value is less or equal than the specified value.
 // By calling Exclusive, the rule will check if the value is strictly less than the specified value.
 // Note that the value being checked and the threshold value must be of the same type.
-// Only int, uint, float and time.Time types are supported.
+// Supports int, uint, float, time.Time, string and []byte thresholds, and
+// thresholds of any other kind (e.g. a struct or pointer) that implement
+// Comparable or are registered with RegisterComparator. json.Number values are
+// parsed for int, uint, float and time.Time thresholds and for types
+// registered with RegisterComparatorParser.
 // An empty value is considered valid. Please use the Required rule to make sure a value is not empty.
 func Max(max any) ThresholdRule {
 	return ThresholdRule{
@@ -76,10 +91,31 @@ func (r ThresholdRule) Exclusive() ThresholdRule {
 	return r
 }
 
+// Strict disables the "a zero value is empty" short-circuit for numeric
+// thresholds (int, uint, and float, including json.Number values compared
+// against them), so e.g. Min(1).Strict() rejects a value of 0 instead of
+// silently accepting it as empty.
+// Thresholds of any other type are unaffected and still treat an empty value
+// as valid.
+func (r ThresholdRule) Strict() ThresholdRule {
+	r.strict = true
+	return r
+}
+
 // Validate checks if the given value is valid or not.
 func (r ThresholdRule) Validate(value any) error {
+	// Registered comparator types (e.g. *big.Int) typically rely on a
+	// pointer receiver for Cmp, so the registry lookup below is matched
+	// against the raw, un-indirected value rather than the dereferenced one.
+	raw := value
+
 	value, isNil := Indirect(value)
-	if isNil || IsEmpty(value) {
+	if isNil {
+		return nil
+	}
+
+	numeric := isNumericKind(reflect.ValueOf(r.threshold).Kind())
+	if !(r.strict && numeric) && IsEmpty(value) {
 		return nil
 	}
 
@@ -117,9 +153,21 @@ func (r ThresholdRule) Validate(value any) error {
 				return err
 			}
 			value = time.Unix(i, 0)
+		default:
+			// If the threshold is of a type with a registered comparator parser
+			// (e.g. *big.Int), parse the json number's string form into that type
+			// so it can be routed into the comparator below.
+			if parser, ok := lookupComparatorParser(reflect.TypeOf(r.threshold)); ok {
+				v, err := parser(jsonNumber.String())
+				if err != nil {
+					return err
+				}
+				value = v
+				raw = v
+			}
 		}
 
-		if IsEmpty(value) {
+		if !(r.strict && numeric) && IsEmpty(value) {
 			return nil
 		}
 	}
@@ -127,20 +175,20 @@ func (r ThresholdRule) Validate(value any) error {
 	rv := reflect.ValueOf(r.threshold)
 	switch rv.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		v, err := ToInt(value)
+		ok, err := r.compareIntThreshold(rv.Int(), value)
 		if err != nil {
 			return err
 		}
-		if r.compareInt(rv.Int(), v) {
+		if ok {
 			return nil
 		}
 
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		v, err := ToUint(value)
+		ok, err := r.compareUintThreshold(rv.Uint(), value)
 		if err != nil {
 			return err
 		}
-		if r.compareUint(rv.Uint(), v) {
+		if ok {
 			return nil
 		}
 
@@ -156,6 +204,17 @@ func (r ThresholdRule) Validate(value any) error {
 	case reflect.Struct:
 		t, ok := r.threshold.(time.Time)
 		if !ok {
+			// A non-time.Time struct threshold, e.g. a custom domain type.
+			// Fall back to a registered Comparable or Comparator.
+			if n, registered, err := compareRegistered(r.threshold, raw); registered {
+				if err != nil {
+					return err
+				}
+				if r.compareSign(n) {
+					return nil
+				}
+				return r.err.SetParams(map[string]any{"threshold": r.threshold})
+			}
 			return fmt.Errorf("type not supported: %v", rv.Type())
 		}
 		v, ok := value.(time.Time)
@@ -166,7 +225,48 @@ func (r ThresholdRule) Validate(value any) error {
 			return nil
 		}
 
+	case reflect.String:
+		t, ok := r.threshold.(string)
+		if !ok {
+			return fmt.Errorf("type not supported: %v", rv.Type())
+		}
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("cannot convert %v to string", reflect.TypeOf(value))
+		}
+		if r.compareString(t, v) {
+			return nil
+		}
+
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("type not supported: %v", rv.Type())
+		}
+		t, ok := r.threshold.([]byte)
+		if !ok {
+			return fmt.Errorf("type not supported: %v", rv.Type())
+		}
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("cannot convert %v to []byte", reflect.TypeOf(value))
+		}
+		if r.compareBytes(t, v) {
+			return nil
+		}
+
 	default:
+		// Fall back to a registered Comparable implementation or Comparator for
+		// types reflect.Kind cannot natively order, e.g. *big.Int or a custom
+		// domain type.
+		if n, ok, err := compareRegistered(r.threshold, raw); ok {
+			if err != nil {
+				return err
+			}
+			if r.compareSign(n) {
+				return nil
+			}
+			return r.err.SetParams(map[string]any{"threshold": r.threshold})
+		}
 		return fmt.Errorf("type not supported: %v", rv.Type())
 	}
 
@@ -185,6 +285,63 @@ func (r ThresholdRule) ErrorObject(err Error) ThresholdRule {
 	return r
 }
 
+// compareIntThreshold compares an int64 threshold against value, which may
+// be an unsigned integer too large to fit in int64 (e.g. uint64 values
+// above math.MaxInt64). In that case the comparison is promoted to big.Int
+// instead of handing the value to ToInt, which would otherwise wrap or
+// error.
+func (r ThresholdRule) compareIntThreshold(threshold int64, value any) (bool, error) {
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := rv.Uint()
+		if u > math.MaxInt64 {
+			n := new(big.Int).SetUint64(u).Cmp(big.NewInt(threshold))
+			return r.compareSign(n), nil
+		}
+		return r.compareInt(threshold, int64(u)), nil
+	default:
+		v, err := ToInt(value)
+		if err != nil {
+			return false, err
+		}
+		return r.compareInt(threshold, v), nil
+	}
+}
+
+// compareUintThreshold compares a uint64 threshold against value, which may
+// be a signed integer. A negative signed value is always less than any
+// unsigned threshold, so it is handled directly instead of being handed to
+// ToUint, which would otherwise wrap or error.
+func (r ThresholdRule) compareUintThreshold(threshold uint64, value any) (bool, error) {
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i := rv.Int()
+		if i < 0 {
+			return r.compareSign(-1), nil
+		}
+		return r.compareUint(threshold, uint64(i)), nil
+	default:
+		v, err := ToUint(value)
+		if err != nil {
+			return false, err
+		}
+		return r.compareUint(threshold, v), nil
+	}
+}
+
+func isNumericKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
 func (r ThresholdRule) compareInt(threshold, value int64) bool {
 	switch r.operator {
 	case greaterThan:
@@ -224,6 +381,30 @@ func (r ThresholdRule) compareFloat(threshold, value float64) bool {
 	}
 }
 
+func (r ThresholdRule) compareString(threshold, value string) bool {
+	return r.compareInt(0, int64(strings.Compare(value, threshold)))
+}
+
+func (r ThresholdRule) compareBytes(threshold, value []byte) bool {
+	return r.compareInt(0, int64(bytes.Compare(value, threshold)))
+}
+
+// compareSign evaluates the result of a Comparator/Comparable comparison
+// (Cmp(value, threshold) semantics: negative if value < threshold, zero if
+// equal, positive if value > threshold) against the rule's operator.
+func (r ThresholdRule) compareSign(n int) bool {
+	switch r.operator {
+	case greaterThan:
+		return n > 0
+	case greaterEqualThan:
+		return n >= 0
+	case lessThan:
+		return n < 0
+	default:
+		return n <= 0
+	}
+}
+
 func (r ThresholdRule) compareTime(threshold, value time.Time) bool {
 	switch r.operator {
 	case greaterThan:
@@ -236,3 +417,95 @@ func (r ThresholdRule) compareTime(threshold, value time.Time) bool {
 		return value.Before(threshold) || value.Equal(threshold)
 	}
 }
+
+// MinT returns a type-safe validation rule that checks if a value is greater
+// or equal than the specified value. By calling Exclusive, the rule will
+// check if the value is strictly greater than the specified value. Unlike
+// Min, MinT works with any constraints.Ordered type directly, without going
+// through reflection or the Comparator registry.
+// An empty value is considered valid. Please use the Required rule to make
+// sure a value is not empty.
+func MinT[T constraints.Ordered](min T) ThresholdRuleT[T] {
+	return ThresholdRuleT[T]{
+		threshold: min,
+		operator:  greaterEqualThan,
+		err:       ErrMinGreaterEqualThanRequired,
+	}
+}
+
+// MaxT returns a type-safe validation rule that checks if a value is less
+// or equal than the specified value. By calling Exclusive, the rule will
+// check if the value is strictly less than the specified value. Unlike
+// Max, MaxT works with any constraints.Ordered type directly, without going
+// through reflection or the Comparator registry.
+// An empty value is considered valid. Please use the Required rule to make
+// sure a value is not empty.
+func MaxT[T constraints.Ordered](max T) ThresholdRuleT[T] {
+	return ThresholdRuleT[T]{
+		threshold: max,
+		operator:  lessEqualThan,
+		err:       ErrMaxLessEqualThanRequired,
+	}
+}
+
+// ThresholdRuleT is the generic, type-safe counterpart to ThresholdRule.
+type ThresholdRuleT[T constraints.Ordered] struct {
+	threshold T
+	operator  int
+	err       Error
+}
+
+// Exclusive sets the comparison to exclude the boundary value.
+func (r ThresholdRuleT[T]) Exclusive() ThresholdRuleT[T] {
+	switch r.operator {
+	case greaterEqualThan:
+		r.operator = greaterThan
+		r.err = ErrMinGreaterThanRequired
+	case lessEqualThan:
+		r.operator = lessThan
+		r.err = ErrMaxLessThanRequired
+	}
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r ThresholdRuleT[T]) Validate(value any) error {
+	v, isNil := Indirect(value)
+	if isNil || IsEmpty(v) {
+		return nil
+	}
+
+	t, ok := v.(T)
+	if !ok {
+		return fmt.Errorf("cannot convert %v to %v", reflect.TypeOf(v), reflect.TypeOf(r.threshold))
+	}
+
+	var valid bool
+	switch r.operator {
+	case greaterThan:
+		valid = t > r.threshold
+	case greaterEqualThan:
+		valid = t >= r.threshold
+	case lessThan:
+		valid = t < r.threshold
+	default:
+		valid = t <= r.threshold
+	}
+	if valid {
+		return nil
+	}
+
+	return r.err.SetParams(map[string]any{"threshold": r.threshold})
+}
+
+// Error sets the error message for the rule.
+func (r ThresholdRuleT[T]) Error(message string) ThresholdRuleT[T] {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r ThresholdRuleT[T]) ErrorObject(err Error) ThresholdRuleT[T] {
+	r.err = err
+	return r
+}
@@ -6,6 +6,7 @@ package validation
 
 import (
 	"encoding/json"
+	"math"
 	"testing"
 	"time"
 
@@ -32,7 +33,7 @@ func TestMin(t *testing.T) {
 		{"t1.4", 1, false, 0, ""},
 		{"t1.5", 1, true, 1, "must be greater than 1"},
 		{"t1.6", 1, false, "1", "cannot convert string to int64"},
-		{"t1.7", "1", false, 1, "type not supported: string"},
+		{"t1.7", "1", false, 1, "cannot convert int to string"},
 		// uint cases
 		{"t2.1", uint(2), false, uint(2), ""},
 		{"t2.2", uint(2), false, uint(3), ""},
@@ -71,6 +72,19 @@ func TestMin(t *testing.T) {
 		// less than 1, this is considered okay?
 		{"t5.9", float64(1), false, json.Number("0"), ""},
 		{"t5.10", float64(1), true, json.Number("1"), "must be greater than 1"},
+		// string cases
+		{"t6.1", "2024-01-01", false, "2024-01-01", ""},
+		{"t6.2", "2024-01-01", false, "2024-06-01", ""},
+		{"t6.3", "2024-06-01", false, "2024-01-01", "must be no less than 2024-06-01"},
+		{"t6.4", "2024-01-01", true, "2024-01-01", "must be greater than 2024-01-01"},
+		{"t6.5", "2024-01-01", false, 1, "cannot convert int to string"},
+		{"t6.6", "2024-06-01", false, "", ""},
+		// []byte cases
+		{"t7.1", []byte{0x01}, false, []byte{0x01}, ""},
+		{"t7.2", []byte{0x01}, false, []byte{0x02}, ""},
+		{"t7.3", []byte{0x02}, false, []byte{0x01}, "must be no less than [2]"},
+		{"t7.4", []byte{0x01}, true, []byte{0x01}, "must be greater than [1]"},
+		{"t7.5", []byte{0x01}, false, "a", "cannot convert string to []byte"},
 	}
 
 	for _, test := range tests {
@@ -83,6 +97,80 @@ func TestMin(t *testing.T) {
 	}
 }
 
+func TestMin_Strict(t *testing.T) {
+	tests := []struct {
+		tag       string
+		threshold any
+		strict    bool
+		value     any
+		err       string
+	}{
+		{"t1.1", 1, false, 0, ""},
+		{"t1.2", uint(1), false, uint(0), ""},
+		{"t1.3", float64(1), false, float64(0), ""},
+		{"t1.4", float64(1), false, json.Number("0"), ""},
+		{"t2.1", 1, true, 0, "must be no less than 1"},
+		{"t2.2", uint(1), true, uint(0), "must be no less than 1"},
+		{"t2.3", float64(1), true, float64(0), "must be no less than 1"},
+		{"t2.4", float64(1), true, json.Number("0"), "must be no less than 1"},
+	}
+
+	for _, test := range tests {
+		r := Min(test.threshold)
+		if test.strict {
+			r = r.Strict()
+		}
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestMax_Strict(t *testing.T) {
+	tests := []struct {
+		tag       string
+		threshold any
+		strict    bool
+		value     any
+		err       string
+	}{
+		{"t1.1", 1, false, 0, ""},
+		{"t1.2", uint(1), false, uint(0), ""},
+		{"t1.3", float64(1), false, float64(0), ""},
+		{"t1.4", float64(1), false, json.Number("0"), ""},
+		{"t2.1", -1, true, 0, "must be no greater than -1"},
+		{"t2.2", uint(0), true, uint(0), ""},
+		{"t2.3", float64(-1), true, float64(0), "must be no greater than -1"},
+		{"t2.4", float64(-1), true, json.Number("0"), "must be no greater than -1"},
+	}
+
+	for _, test := range tests {
+		r := Max(test.threshold)
+		if test.strict {
+			r = r.Strict()
+		}
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestMin_Uintptr(t *testing.T) {
+	assert.NoError(t, Min(uintptr(1)).Validate(uintptr(2)))
+	assert.Error(t, Min(uintptr(5)).Validate(uintptr(3)))
+}
+
+func TestMin_UnsignedOverflow(t *testing.T) {
+	// uint64(math.MaxInt64)+1 does not fit in an int64, so the comparison
+	// must be promoted to big.Int instead of corrupting via ToInt. The value
+	// is still clearly >= 0, so this must pass.
+	assert.NoError(t, Min(int64(0)).Validate(uint64(math.MaxInt64)+1))
+}
+
+func TestMax_UnsignedOverflow(t *testing.T) {
+	// math.MaxUint64 vastly exceeds math.MaxInt64, so naively converting it
+	// to int64 would wrap around to -1 and incorrectly pass this check.
+	assert.Error(t, Max(int64(-1)).Validate(uint64(math.MaxUint64)))
+}
+
 func TestMinError(t *testing.T) {
 	r := Min(10)
 	assert.Equal(t, "must be no less than 10", r.Validate(9).Error())
@@ -111,7 +199,7 @@ func TestMax(t *testing.T) {
 		{"t1.4", 2, false, 0, ""},
 		{"t1.5", 2, true, 2, "must be less than 2"},
 		{"t1.6", 2, false, "1", "cannot convert string to int64"},
-		{"t1.7", "1", false, 1, "type not supported: string"},
+		{"t1.7", "1", false, 1, "cannot convert int to string"},
 		// uint cases
 		{"t2.1", uint(2), false, uint(2), ""},
 		{"t2.2", uint(2), false, uint(1), ""},
@@ -140,6 +228,17 @@ func TestMax(t *testing.T) {
 		// less than 1, this is considered okay?
 		{"t5.4", 2, false, json.Number("0"), ""},
 		{"t5.5", 2, true, json.Number("2"), "must be less than 2"},
+		// string cases
+		{"t6.1", "2024-06-01", false, "2024-06-01", ""},
+		{"t6.2", "2024-06-01", false, "2024-01-01", ""},
+		{"t6.3", "2024-01-01", false, "2024-06-01", "must be no greater than 2024-01-01"},
+		{"t6.4", "2024-06-01", true, "2024-06-01", "must be less than 2024-06-01"},
+		{"t6.5", "2024-06-01", false, "", ""},
+		// []byte cases
+		{"t7.1", []byte{0x02}, false, []byte{0x02}, ""},
+		{"t7.2", []byte{0x02}, false, []byte{0x01}, ""},
+		{"t7.3", []byte{0x01}, false, []byte{0x02}, "must be no greater than [1]"},
+		{"t7.4", []byte{0x02}, true, []byte{0x02}, "must be less than [2]"},
 	}
 
 	for _, test := range tests {